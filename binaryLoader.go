@@ -0,0 +1,226 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description		CBOR and MessagePack loaders, for binary payloads such as MQTT/CoAP messages.
+//
+// created          27-07-2026
+
+package gojsonschema
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+
+	"github.com/fxamacker/cbor/v2"
+	"github.com/vmihailenco/msgpack/v5"
+	"github.com/xeipuuv/gojsonreference"
+)
+
+// JSON CBOR bytes loader
+
+type jsonCBORBytesLoader struct {
+	source []byte
+}
+
+func (l *jsonCBORBytesLoader) JsonSource() interface{} {
+	return l.source
+}
+
+func (l *jsonCBORBytesLoader) JsonReference() (gojsonreference.JsonReference, error) {
+	return gojsonreference.NewJsonReference("#")
+}
+
+func (l *jsonCBORBytesLoader) LoaderFactory() JSONLoaderFactory {
+	return &DefaultJSONLoaderFactory{}
+}
+
+// NewCBORBytesLoader creates a new JSONLoader, taking a `[]byte` of CBOR as source
+func NewCBORBytesLoader(source []byte) JSONLoader {
+	return &jsonCBORBytesLoader{source: source}
+}
+
+func (l *jsonCBORBytesLoader) LoadJSON() (interface{}, error) {
+	var document interface{}
+	if err := cbor.Unmarshal(l.source, &document); err != nil {
+		return nil, err
+	}
+
+	return normalizeBinaryDocument(document)
+}
+
+// NewCBORReaderLoader creates a new JSON loader using the provided io.Reader of CBOR
+func NewCBORReaderLoader(source io.Reader) (JSONLoader, io.Reader) {
+	return newBinaryReaderLoader(source, NewCBORBytesLoader)
+}
+
+// JSON MessagePack bytes loader
+
+type jsonMsgpackBytesLoader struct {
+	source []byte
+}
+
+func (l *jsonMsgpackBytesLoader) JsonSource() interface{} {
+	return l.source
+}
+
+func (l *jsonMsgpackBytesLoader) JsonReference() (gojsonreference.JsonReference, error) {
+	return gojsonreference.NewJsonReference("#")
+}
+
+func (l *jsonMsgpackBytesLoader) LoaderFactory() JSONLoaderFactory {
+	return &DefaultJSONLoaderFactory{}
+}
+
+// NewMsgpackBytesLoader creates a new JSONLoader, taking a `[]byte` of MessagePack as source
+func NewMsgpackBytesLoader(source []byte) JSONLoader {
+	return &jsonMsgpackBytesLoader{source: source}
+}
+
+func (l *jsonMsgpackBytesLoader) LoadJSON() (interface{}, error) {
+	var document interface{}
+	if err := msgpack.Unmarshal(l.source, &document); err != nil {
+		return nil, err
+	}
+
+	return normalizeBinaryDocument(document)
+}
+
+// NewMsgpackReaderLoader creates a new JSON loader using the provided io.Reader of MessagePack
+func NewMsgpackReaderLoader(source io.Reader) (JSONLoader, io.Reader) {
+	return newBinaryReaderLoader(source, NewMsgpackBytesLoader)
+}
+
+type jsonBinaryReaderLoader struct {
+	raw       []byte
+	readErr   error
+	fromBytes func([]byte) JSONLoader
+}
+
+// newBinaryReaderLoader reads source eagerly (CBOR/MessagePack decoders need
+// the whole payload up front). A read error is kept on the loader and
+// returned from LoadJSON, rather than silently treated as an empty payload.
+func newBinaryReaderLoader(source io.Reader, fromBytes func([]byte) JSONLoader) (JSONLoader, io.Reader) {
+	raw, err := ioutil.ReadAll(source)
+
+	loader := &jsonBinaryReaderLoader{raw: raw, readErr: err, fromBytes: fromBytes}
+	return loader, bytes.NewReader(raw)
+}
+
+func (l *jsonBinaryReaderLoader) JsonSource() interface{} {
+	return l.raw
+}
+
+func (l *jsonBinaryReaderLoader) JsonReference() (gojsonreference.JsonReference, error) {
+	return gojsonreference.NewJsonReference("#")
+}
+
+func (l *jsonBinaryReaderLoader) LoaderFactory() JSONLoaderFactory {
+	return &DefaultJSONLoaderFactory{}
+}
+
+func (l *jsonBinaryReaderLoader) LoadJSON() (interface{}, error) {
+	if l.readErr != nil {
+		return nil, l.readErr
+	}
+
+	return l.fromBytes(l.raw).LoadJSON()
+}
+
+// normalizeBinaryDocument converts the generic interface{} tree a CBOR/MessagePack
+// decoder produces into the same shape the JSON loaders produce: map keys are
+// always strings (erroring on non-string keys, per JSON Schema's data model),
+// integers become json.Number, and byte strings become base64 strings so that
+// `contentEncoding: base64` / `format: byte` schemas match unchanged.
+func normalizeBinaryDocument(value interface{}) (interface{}, error) {
+	switch v := value.(type) {
+
+	case map[interface{}]interface{}:
+		result := make(map[string]interface{}, len(v))
+		for key, child := range v {
+			strKey, ok := key.(string)
+			if !ok {
+				return nil, fmt.Errorf("gojsonschema: non-string map key %v in binary payload", key)
+			}
+
+			normalized, err := normalizeBinaryDocument(child)
+			if err != nil {
+				return nil, err
+			}
+			result[strKey] = normalized
+		}
+		return result, nil
+
+	case map[string]interface{}:
+		for key, child := range v {
+			normalized, err := normalizeBinaryDocument(child)
+			if err != nil {
+				return nil, err
+			}
+			v[key] = normalized
+		}
+		return v, nil
+
+	case []interface{}:
+		for i, child := range v {
+			normalized, err := normalizeBinaryDocument(child)
+			if err != nil {
+				return nil, err
+			}
+			v[i] = normalized
+		}
+		return v, nil
+
+	case []byte:
+		return base64.StdEncoding.EncodeToString(v), nil
+
+	case int8:
+		return json.Number(fmt.Sprintf("%d", v)), nil
+	case int16:
+		return json.Number(fmt.Sprintf("%d", v)), nil
+	case int32:
+		return json.Number(fmt.Sprintf("%d", v)), nil
+	case int64:
+		return json.Number(fmt.Sprintf("%d", v)), nil
+	case int:
+		return json.Number(fmt.Sprintf("%d", v)), nil
+	case uint8:
+		return json.Number(fmt.Sprintf("%d", v)), nil
+	case uint16:
+		return json.Number(fmt.Sprintf("%d", v)), nil
+	case uint32:
+		return json.Number(fmt.Sprintf("%d", v)), nil
+	case uint64:
+		return json.Number(fmt.Sprintf("%d", v)), nil
+	case uint:
+		return json.Number(fmt.Sprintf("%d", v)), nil
+	case float32:
+		return json.Number(fmt.Sprintf("%v", v)), nil
+	case float64:
+		return json.Number(fmt.Sprintf("%v", v)), nil
+
+	default:
+		return value, nil
+	}
+}
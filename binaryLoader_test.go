@@ -0,0 +1,59 @@
+package gojsonschema
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+)
+
+type erroringReader struct {
+	err error
+}
+
+func (r erroringReader) Read(p []byte) (int, error) {
+	return 0, r.err
+}
+
+func TestBinaryReaderLoaderSurfacesReadError(t *testing.T) {
+
+	wantErr := errors.New("connection reset by peer")
+
+	loader, _ := NewCBORReaderLoader(erroringReader{err: wantErr})
+
+	_, err := loader.LoadJSON()
+	if !errors.Is(err, wantErr) {
+		t.Fatalf("expected the underlying read error %v, got %v", wantErr, err)
+	}
+}
+
+func TestNormalizeBinaryDocumentRejectsNonStringKeys(t *testing.T) {
+
+	_, err := normalizeBinaryDocument(map[interface{}]interface{}{1: "a"})
+	if err == nil {
+		t.Fatalf("expected an error for a non-string map key")
+	}
+}
+
+func TestNormalizeBinaryDocumentConvertsIntsAndBytes(t *testing.T) {
+
+	document, err := normalizeBinaryDocument(map[interface{}]interface{}{
+		"id":      uint64(42),
+		"payload": []byte{0xDE, 0xAD},
+	})
+	if err != nil {
+		t.Fatalf("normalizeBinaryDocument: %v", err)
+	}
+
+	m, ok := document.(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected a map, got %T", document)
+	}
+
+	if id, ok := m["id"].(json.Number); !ok || id.String() != "42" {
+		t.Fatalf("expected id to be json.Number \"42\", got %v", m["id"])
+	}
+
+	if payload, ok := m["payload"].(string); !ok || payload != "3q0=" {
+		t.Fatalf("expected payload to be base64 \"3q0=\", got %v", m["payload"])
+	}
+}
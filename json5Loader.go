@@ -0,0 +1,288 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description		JSON5 loaders, for configs that rely on comments and trailing commas.
+//
+// created          27-07-2026
+
+package gojsonschema
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"strings"
+
+	"github.com/xeipuuv/gojsonreference"
+)
+
+// JSON5 string loader
+
+type json5StringLoader struct {
+	source string
+}
+
+func (l *json5StringLoader) JsonSource() interface{} {
+	return l.source
+}
+
+func (l *json5StringLoader) JsonReference() (gojsonreference.JsonReference, error) {
+	return gojsonreference.NewJsonReference("#")
+}
+
+func (l *json5StringLoader) LoaderFactory() JSONLoaderFactory {
+	return &DefaultJSONLoaderFactory{}
+}
+
+// NewJSON5StringLoader creates a new JSONLoader, taking a JSON5 string as source
+func NewJSON5StringLoader(source string) JSONLoader {
+	return &json5StringLoader{source: source}
+}
+
+func (l *json5StringLoader) LoadJSON() (interface{}, error) {
+	return decodeJSON5UsingNumber(strings.NewReader(l.JsonSource().(string)))
+}
+
+// JSON5 bytes loader
+
+type json5BytesLoader struct {
+	source []byte
+}
+
+func (l *json5BytesLoader) JsonSource() interface{} {
+	return l.source
+}
+
+func (l *json5BytesLoader) JsonReference() (gojsonreference.JsonReference, error) {
+	return gojsonreference.NewJsonReference("#")
+}
+
+func (l *json5BytesLoader) LoaderFactory() JSONLoaderFactory {
+	return &DefaultJSONLoaderFactory{}
+}
+
+// NewJSON5BytesLoader creates a new JSONLoader, taking a `[]byte` of JSON5 as source
+func NewJSON5BytesLoader(source []byte) JSONLoader {
+	return &json5BytesLoader{source: source}
+}
+
+func (l *json5BytesLoader) LoadJSON() (interface{}, error) {
+	return decodeJSON5UsingNumber(bytes.NewReader(l.JsonSource().([]byte)))
+}
+
+// JSON5 reader loader
+
+type json5ReaderLoader struct {
+	buf *bytes.Buffer
+}
+
+// NewJSON5ReaderLoader creates a new JSON loader using the provided io.Reader of JSON5
+func NewJSON5ReaderLoader(source io.Reader) (JSONLoader, io.Reader) {
+	buf := &bytes.Buffer{}
+	return &json5ReaderLoader{buf: buf}, io.TeeReader(source, buf)
+}
+
+func (l *json5ReaderLoader) JsonSource() interface{} {
+	return l.buf.String()
+}
+
+func (l *json5ReaderLoader) JsonReference() (gojsonreference.JsonReference, error) {
+	return gojsonreference.NewJsonReference("#")
+}
+
+func (l *json5ReaderLoader) LoaderFactory() JSONLoaderFactory {
+	return &DefaultJSONLoaderFactory{}
+}
+
+func (l *json5ReaderLoader) LoadJSON() (interface{}, error) {
+	return decodeJSON5UsingNumber(l.buf)
+}
+
+// decodeJSON5UsingNumber tolerates JSON5's comments, trailing commas and
+// relaxed quoting by first rewriting the input into plain JSON text, then
+// decodes it exactly the way decodeJSONUsingNumber does. Routing through
+// encoding/json's UseNumber decoder directly - rather than through a JSON5
+// decoder that parses numbers as float64 - is what lets large integers (e.g.
+// a 19-digit ID) survive without losing precision.
+func decodeJSON5UsingNumber(r io.Reader) (interface{}, error) {
+
+	raw, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	plain, err := json5ToJSON(raw)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeJSONUsingNumber(bytes.NewReader(plain))
+}
+
+// json5ToJSON rewrites the comments, trailing commas, single-quoted strings
+// and unquoted object keys JSON5 allows into their strict-JSON equivalents.
+// It does not touch numbers or double-quoted strings, so their bytes reach
+// the JSON decoder unchanged.
+func json5ToJSON(src []byte) ([]byte, error) {
+
+	var out bytes.Buffer
+	n := len(src)
+
+	for i := 0; i < n; {
+		c := src[i]
+
+		switch {
+
+		case c == '/' && i+1 < n && src[i+1] == '/':
+			i += 2
+			for i < n && src[i] != '\n' {
+				i++
+			}
+
+		case c == '/' && i+1 < n && src[i+1] == '*':
+			i += 2
+			for i+1 < n && !(src[i] == '*' && src[i+1] == '/') {
+				i++
+			}
+			if i+1 >= n {
+				return nil, fmt.Errorf("gojsonschema: unterminated /* comment in JSON5 input")
+			}
+			i += 2
+
+		case c == '\'':
+			out.WriteByte('"')
+			i++
+			for i < n && src[i] != '\'' {
+				switch {
+				case src[i] == '\\' && i+1 < n && src[i+1] == '\'':
+					out.WriteByte('\'')
+					i += 2
+				case src[i] == '\\' && i+1 < n:
+					out.WriteByte(src[i])
+					out.WriteByte(src[i+1])
+					i += 2
+				case src[i] == '"':
+					out.WriteString(`\"`)
+					i++
+				default:
+					out.WriteByte(src[i])
+					i++
+				}
+			}
+			if i >= n {
+				return nil, fmt.Errorf("gojsonschema: unterminated string in JSON5 input")
+			}
+			out.WriteByte('"')
+			i++
+
+		case c == '"':
+			out.WriteByte('"')
+			i++
+			for i < n && src[i] != '"' {
+				if src[i] == '\\' && i+1 < n {
+					out.WriteByte(src[i])
+					out.WriteByte(src[i+1])
+					i += 2
+					continue
+				}
+				out.WriteByte(src[i])
+				i++
+			}
+			if i >= n {
+				return nil, fmt.Errorf("gojsonschema: unterminated string in JSON5 input")
+			}
+			out.WriteByte('"')
+			i++
+
+		case c == ',':
+			if isJSON5TrailingComma(src, i+1) {
+				i++
+				continue
+			}
+			out.WriteByte(c)
+			i++
+
+		case c >= '0' && c <= '9':
+			for i < n && (isJSON5IdentPart(src[i]) || src[i] == '.' ||
+				((src[i] == '+' || src[i] == '-') && (src[i-1] == 'e' || src[i-1] == 'E'))) {
+				out.WriteByte(src[i])
+				i++
+			}
+
+		case isJSON5IdentStart(c):
+			start := i
+			for i < n && isJSON5IdentPart(src[i]) {
+				i++
+			}
+			word := string(src[start:i])
+			switch word {
+			case "true", "false", "null":
+				out.WriteString(word)
+			default:
+				out.WriteByte('"')
+				out.WriteString(word)
+				out.WriteByte('"')
+			}
+
+		default:
+			out.WriteByte(c)
+			i++
+		}
+	}
+
+	return out.Bytes(), nil
+}
+
+// isJSON5TrailingComma reports whether the comma is followed, modulo
+// whitespace and comments, by a closing ] or } - i.e. whether dropping it
+// is what turns JSON5 into valid JSON rather than a syntax error.
+func isJSON5TrailingComma(src []byte, i int) bool {
+	n := len(src)
+	for i < n {
+		switch {
+		case src[i] == ' ' || src[i] == '\t' || src[i] == '\n' || src[i] == '\r':
+			i++
+		case src[i] == '/' && i+1 < n && src[i+1] == '/':
+			i += 2
+			for i < n && src[i] != '\n' {
+				i++
+			}
+		case src[i] == '/' && i+1 < n && src[i+1] == '*':
+			i += 2
+			for i+1 < n && !(src[i] == '*' && src[i+1] == '/') {
+				i++
+			}
+			i += 2
+		default:
+			return src[i] == ']' || src[i] == '}'
+		}
+	}
+	return false
+}
+
+func isJSON5IdentStart(c byte) bool {
+	return c == '_' || c == '$' || (c >= 'a' && c <= 'z') || (c >= 'A' && c <= 'Z')
+}
+
+func isJSON5IdentPart(c byte) bool {
+	return isJSON5IdentStart(c) || (c >= '0' && c <= '9')
+}
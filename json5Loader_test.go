@@ -0,0 +1,61 @@
+package gojsonschema
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestJSON5LoaderPreservesLargeIntegerPrecision(t *testing.T) {
+
+	loader := NewJSON5StringLoader(`{
+		// a 64-bit ID that doesn't fit in a float64 without losing precision
+		id: 12345678901234567,
+	}`)
+
+	document, err := loader.LoadJSON()
+	if err != nil {
+		t.Fatalf("LoadJSON: %v", err)
+	}
+
+	m, ok := document.(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected a map, got %T", document)
+	}
+
+	id, ok := m["id"].(json.Number)
+	if !ok {
+		t.Fatalf("expected id to be a json.Number, got %T", m["id"])
+	}
+
+	if id.String() != "12345678901234567" {
+		t.Fatalf("expected id to round-trip exactly, got %s", id.String())
+	}
+}
+
+func TestJSON5LoaderHandlesCommentsTrailingCommasAndQuoting(t *testing.T) {
+
+	loader := NewJSON5StringLoader(`{
+		/* block comment */
+		name: 'o\'brien', // trailing comma below
+		tags: ['a', 'b',],
+	}`)
+
+	document, err := loader.LoadJSON()
+	if err != nil {
+		t.Fatalf("LoadJSON: %v", err)
+	}
+
+	m, ok := document.(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected a map, got %T", document)
+	}
+
+	if m["name"] != "o'brien" {
+		t.Fatalf("expected name %q, got %q", "o'brien", m["name"])
+	}
+
+	tags, ok := m["tags"].([]interface{})
+	if !ok || len(tags) != 2 || tags[0] != "a" || tags[1] != "b" {
+		t.Fatalf("expected tags [a b], got %v", m["tags"])
+	}
+}
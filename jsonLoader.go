@@ -29,12 +29,39 @@ package gojsonschema
 import (
 	"bytes"
 	"encoding/json"
+	"fmt"
 	"io"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
+	"time"
 
 	"github.com/xeipuuv/gojsonreference"
 )
 
+// DefaultHTTPTimeout is the request timeout used by a jsonReferenceLoader
+// that was not given an explicit *http.Client.
+const DefaultHTTPTimeout = 15 * time.Second
+
+// DefaultMaxRedirects bounds the number of redirects a jsonReferenceLoader
+// will follow when resolving an http(s):// reference.
+const DefaultMaxRedirects = 10
+
+func defaultHTTPClient() *http.Client {
+	return &http.Client{
+		Timeout: DefaultHTTPTimeout,
+		CheckRedirect: func(req *http.Request, via []*http.Request) error {
+			if len(via) >= DefaultMaxRedirects {
+				return fmt.Errorf("gojsonschema: stopped after %d redirects", DefaultMaxRedirects)
+			}
+			return nil
+		},
+	}
+}
+
 // JSONLoader defines the JSON loader interface
 type JSONLoader interface {
 	JsonSource() interface{}
@@ -55,12 +82,14 @@ type DefaultJSONLoaderFactory struct {
 
 // FileSystemJSONLoaderFactory is a JSON loader factory that uses http.FileSystem
 type FileSystemJSONLoaderFactory struct {
+	fs http.FileSystem
 }
 
 // New creates a new JSON loader for the given source
 func (d DefaultJSONLoaderFactory) New(source string) JSONLoader {
 	return &jsonReferenceLoader{
 		source: source,
+		client: defaultHTTPClient(),
 	}
 }
 
@@ -68,14 +97,27 @@ func (d DefaultJSONLoaderFactory) New(source string) JSONLoader {
 func (f FileSystemJSONLoaderFactory) New(source string) JSONLoader {
 	return &jsonReferenceLoader{
 		source: source,
+		client: defaultHTTPClient(),
+		fs:     f.fs,
 	}
 }
 
+// NewFileSystemJSONLoaderFactory returns a FileSystemJSONLoaderFactory that serves
+// file:// references out of fs instead of the local OS file system. This allows
+// schemas to be served from an embed.FS, a tarball, or any other virtual file system.
+func NewFileSystemJSONLoaderFactory(fs http.FileSystem) FileSystemJSONLoaderFactory {
+	return FileSystemJSONLoaderFactory{fs: fs}
+}
+
 // JSON Reference loader
 // references are used to load JSONs from files and HTTP
 
 type jsonReferenceLoader struct {
-	source string
+	source      string
+	client      *http.Client
+	fs          http.FileSystem
+	cache       SchemaCache
+	offlineMode bool
 }
 
 func (l *jsonReferenceLoader) JsonSource() interface{} {
@@ -87,18 +129,132 @@ func (l *jsonReferenceLoader) JsonReference() (gojsonreference.JsonReference, er
 }
 
 func (l *jsonReferenceLoader) LoaderFactory() JSONLoaderFactory {
-	return &FileSystemJSONLoaderFactory{}
+	return &FileSystemJSONLoaderFactory{fs: l.fs}
 }
 
 // NewReferenceLoader returns a JSON reference loader using the given source and the local OS file system.
 func NewReferenceLoader(source string) JSONLoader {
 	return &jsonReferenceLoader{
 		source: source,
+		client: defaultHTTPClient(),
+	}
+}
+
+// NewReferenceLoaderFileSystem returns a JSON reference loader using the given source,
+// resolving file:// references against fs instead of the local OS file system.
+func NewReferenceLoaderFileSystem(source string, fs http.FileSystem) JSONLoader {
+	return &jsonReferenceLoader{
+		source: source,
+		client: defaultHTTPClient(),
+		fs:     fs,
 	}
 }
 
 func (l *jsonReferenceLoader) LoadJSON() (interface{}, error) {
-	return nil, nil
+
+	reference, err := gojsonreference.NewJsonReference(l.JsonSource().(string))
+	if err != nil {
+		return nil, err
+	}
+
+	refToURL := reference
+	refToURL.GetUrl().Fragment = ""
+	canonical := refToURL.String()
+
+	if l.cache != nil {
+		if cached, ok := l.cache.Get(canonical); ok {
+			return cached, nil
+		}
+	}
+
+	var document interface{}
+
+	if reference.HasFileScheme {
+
+		filename := strings.TrimPrefix(canonical, "file://")
+		filename, err = url.QueryUnescape(filename)
+		if err != nil {
+			return nil, err
+		}
+
+		if runtime.GOOS == "windows" {
+			filename = strings.TrimPrefix(filename, "/")
+			filename = filepath.FromSlash(filename)
+		}
+
+		document, err = l.loadFromFile(filename)
+		if err != nil {
+			return nil, err
+		}
+
+	} else {
+
+		if l.offlineMode {
+			return nil, fmt.Errorf("gojsonschema: offline mode enabled, %q is not cached", canonical)
+		}
+
+		document, err = l.loadFromHTTP(canonical)
+		if err != nil {
+			return nil, err
+		}
+
+	}
+
+	if l.cache != nil {
+		l.cache.Set(canonical, document)
+	}
+
+	return document, nil
+
+}
+
+func (l *jsonReferenceLoader) loadFromHTTP(address string) (interface{}, error) {
+
+	req, err := http.NewRequest(http.MethodGet, address, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("Accept", "application/schema+json, application/json")
+
+	client := l.client
+	if client == nil {
+		client = defaultHTTPClient()
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("gojsonschema: could not read %q: %s", address, resp.Status)
+	}
+
+	return decodeJSONUsingNumber(resp.Body)
+
+}
+
+func (l *jsonReferenceLoader) loadFromFile(path string) (interface{}, error) {
+
+	if l.fs != nil {
+		f, err := l.fs.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+
+		return decodeJSONUsingNumber(f)
+	}
+
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	return decodeJSONUsingNumber(f)
+
 }
 
 // JSON string loader
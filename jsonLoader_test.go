@@ -0,0 +1,84 @@
+package gojsonschema
+
+import (
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestReferenceLoaderResolvesFile(t *testing.T) {
+
+	dir := t.TempDir()
+	path := filepath.Join(dir, "schema.json")
+	if err := ioutil.WriteFile(path, []byte(`{"type":"object"}`), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	loader := NewReferenceLoader("file://" + path)
+
+	document, err := loader.LoadJSON()
+	if err != nil {
+		t.Fatalf("LoadJSON: %v", err)
+	}
+
+	m, ok := document.(map[string]interface{})
+	if !ok || m["type"] != "object" {
+		t.Fatalf("expected {type: object}, got %v", document)
+	}
+}
+
+func TestReferenceLoaderFileSystemServesFromVirtualFS(t *testing.T) {
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "schema.json"), []byte(`{"type":"string"}`), 0o644); err != nil {
+		t.Fatalf("WriteFile: %v", err)
+	}
+
+	loader := NewReferenceLoaderFileSystem("file:///schema.json", http.Dir(dir))
+
+	document, err := loader.LoadJSON()
+	if err != nil {
+		t.Fatalf("LoadJSON: %v", err)
+	}
+
+	m, ok := document.(map[string]interface{})
+	if !ok || m["type"] != "string" {
+		t.Fatalf("expected {type: string}, got %v", document)
+	}
+}
+
+func TestReferenceLoaderOfflineModeFailsFastWithoutCache(t *testing.T) {
+
+	loader := &jsonReferenceLoader{
+		source:      "https://example.com/schema.json",
+		offlineMode: true,
+	}
+
+	if _, err := loader.LoadJSON(); err == nil {
+		t.Fatalf("expected offline mode to fail fast on an un-cached remote reference")
+	}
+}
+
+func TestReferenceLoaderOfflineModeServesFromCache(t *testing.T) {
+
+	cache := NewMemorySchemaCache(8)
+	cache.Set("https://example.com/schema.json", map[string]interface{}{"type": "object"})
+
+	loader := &jsonReferenceLoader{
+		source:      "https://example.com/schema.json",
+		offlineMode: true,
+		cache:       cache,
+	}
+
+	document, err := loader.LoadJSON()
+	if err != nil {
+		t.Fatalf("LoadJSON: %v", err)
+	}
+
+	m, ok := document.(map[string]interface{})
+	if !ok || m["type"] != "object" {
+		t.Fatalf("expected {type: object} from cache, got %v", document)
+	}
+}
@@ -0,0 +1,115 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description		Loader for newline-delimited JSON (ndjson / JSON Lines) streams.
+//
+// created          27-07-2026
+
+package gojsonschema
+
+import (
+	"bufio"
+	"bytes"
+	"io"
+
+	"github.com/xeipuuv/gojsonreference"
+)
+
+// NDJSONLoader is a JSONLoader that also iterates over the remaining records
+// of an ndjson/JSON Lines stream, one JSON value per line.
+type NDJSONLoader interface {
+	JSONLoader
+
+	// Next decodes and returns the next record in the stream. It returns
+	// io.EOF once the stream is exhausted.
+	Next() (interface{}, error)
+}
+
+type jsonNDJSONLoader struct {
+	scanner *bufio.Scanner
+	first   interface{}
+	loaded  bool
+}
+
+// NewNDJSONReaderLoader returns an NDJSONLoader that reads one JSON value per
+// line from source without buffering the whole stream in memory. The first
+// record is also exposed through the regular JSONLoader interface so it
+// composes with the existing Validate APIs; subsequent records are read with Next.
+func NewNDJSONReaderLoader(source io.Reader) JSONLoader {
+	scanner := bufio.NewScanner(source)
+	scanner.Buffer(make([]byte, 0, 64*1024), 1024*1024)
+
+	return &jsonNDJSONLoader{scanner: scanner}
+}
+
+func (l *jsonNDJSONLoader) nextLine() (interface{}, error) {
+	for l.scanner.Scan() {
+		line := l.scanner.Bytes()
+		if len(bytes.TrimSpace(line)) == 0 {
+			continue
+		}
+		return decodeJSONUsingNumber(bytes.NewReader(line))
+	}
+
+	if err := l.scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return nil, io.EOF
+}
+
+func (l *jsonNDJSONLoader) Next() (interface{}, error) {
+	if !l.loaded {
+		// LoadJSON has not run, so the first record is still in the stream
+		// and nextLine below returns it.
+		l.loaded = true
+		if l.first != nil {
+			return l.first, nil
+		}
+	}
+
+	return l.nextLine()
+}
+
+func (l *jsonNDJSONLoader) JsonSource() interface{} {
+	return l.first
+}
+
+func (l *jsonNDJSONLoader) LoadJSON() (interface{}, error) {
+	if !l.loaded {
+		record, err := l.nextLine()
+		if err != nil {
+			return nil, err
+		}
+		l.first = record
+		l.loaded = true
+	}
+
+	return l.first, nil
+}
+
+func (l *jsonNDJSONLoader) JsonReference() (gojsonreference.JsonReference, error) {
+	return gojsonreference.NewJsonReference("#")
+}
+
+func (l *jsonNDJSONLoader) LoaderFactory() JSONLoaderFactory {
+	return &DefaultJSONLoaderFactory{}
+}
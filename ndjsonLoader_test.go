@@ -0,0 +1,76 @@
+package gojsonschema
+
+import (
+	"encoding/json"
+	"io"
+	"strings"
+	"testing"
+)
+
+func TestNDJSONLoaderLoadJSONIsIdempotent(t *testing.T) {
+
+	loader := NewNDJSONReaderLoader(strings.NewReader("{\"a\":1}\n{\"a\":2}\n{\"a\":3}\n"))
+
+	first, err := loader.LoadJSON()
+	if err != nil {
+		t.Fatalf("LoadJSON: %v", err)
+	}
+
+	again, err := loader.LoadJSON()
+	if err != nil {
+		t.Fatalf("second LoadJSON: %v", err)
+	}
+
+	firstJSON, _ := json.Marshal(first)
+	againJSON, _ := json.Marshal(again)
+	if string(firstJSON) != string(againJSON) {
+		t.Fatalf("LoadJSON is not idempotent: got %s then %s", firstJSON, againJSON)
+	}
+
+	ndjson := loader.(NDJSONLoader)
+
+	second, err := ndjson.Next()
+	if err != nil {
+		t.Fatalf("Next (record 2): %v", err)
+	}
+	secondJSON, _ := json.Marshal(second)
+	if string(secondJSON) != `{"a":2}` {
+		t.Fatalf("expected second record {\"a\":2}, got %s", secondJSON)
+	}
+
+	third, err := ndjson.Next()
+	if err != nil {
+		t.Fatalf("Next (record 3): %v", err)
+	}
+	thirdJSON, _ := json.Marshal(third)
+	if string(thirdJSON) != `{"a":3}` {
+		t.Fatalf("expected third record {\"a\":3}, got %s", thirdJSON)
+	}
+
+	if _, err := ndjson.Next(); err != io.EOF {
+		t.Fatalf("expected io.EOF after the last record, got %v", err)
+	}
+}
+
+func TestNDJSONLoaderSkipsBlankLines(t *testing.T) {
+
+	loader := NewNDJSONReaderLoader(strings.NewReader("{\"a\":1}\n\n{\"a\":2}\n"))
+
+	ndjson := loader.(NDJSONLoader)
+
+	first, err := ndjson.Next()
+	if err != nil {
+		t.Fatalf("Next (record 1): %v", err)
+	}
+	if js, _ := json.Marshal(first); string(js) != `{"a":1}` {
+		t.Fatalf("expected first record {\"a\":1}, got %s", js)
+	}
+
+	second, err := ndjson.Next()
+	if err != nil {
+		t.Fatalf("Next (record 2): %v", err)
+	}
+	if js, _ := json.Marshal(second); string(js) != `{"a":2}` {
+		t.Fatalf("expected second record {\"a\":2}, got %s", js)
+	}
+}
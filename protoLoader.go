@@ -0,0 +1,111 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description		Loaders for validating the JSON projection of protobuf messages.
+//
+// created          27-07-2026
+
+package gojsonschema
+
+import (
+	"bytes"
+
+	"github.com/xeipuuv/gojsonreference"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/anypb"
+)
+
+var protoMarshalOptions = protojson.MarshalOptions{
+	UseProtoNames:   true,
+	EmitUnpopulated: false,
+}
+
+// JSON protobuf loader
+
+type jsonProtoLoader struct {
+	source proto.Message
+}
+
+func (l *jsonProtoLoader) JsonSource() interface{} {
+	return l.source
+}
+
+func (l *jsonProtoLoader) JsonReference() (gojsonreference.JsonReference, error) {
+	return gojsonreference.NewJsonReference("#")
+}
+
+func (l *jsonProtoLoader) LoaderFactory() JSONLoaderFactory {
+	return &DefaultJSONLoaderFactory{}
+}
+
+// NewProtoLoader creates a new JSONLoader from a protobuf message, via its
+// protojson projection. protojson renders the google.protobuf.Timestamp
+// well-known type as an RFC 3339 string, so existing `format: date-time`
+// schema keywords work against it unchanged, and google.protobuf.Duration
+// as a decimal-seconds string such as "1.5s".
+func NewProtoLoader(source proto.Message) JSONLoader {
+	return &jsonProtoLoader{source: source}
+}
+
+func (l *jsonProtoLoader) LoadJSON() (interface{}, error) {
+
+	jsonBytes, err := protoMarshalOptions.Marshal(l.source)
+	if err != nil {
+		return nil, err
+	}
+
+	return decodeJSONUsingNumber(bytes.NewReader(jsonBytes))
+}
+
+// JSON protobuf Any loader
+
+type jsonAnyLoader struct {
+	source *anypb.Any
+}
+
+func (l *jsonAnyLoader) JsonSource() interface{} {
+	return l.source
+}
+
+func (l *jsonAnyLoader) JsonReference() (gojsonreference.JsonReference, error) {
+	return gojsonreference.NewJsonReference("#")
+}
+
+func (l *jsonAnyLoader) LoaderFactory() JSONLoaderFactory {
+	return &DefaultJSONLoaderFactory{}
+}
+
+// NewAnyLoader creates a new JSONLoader from a google.protobuf.Any, unpacking
+// it to its concrete, registered message type before projecting it to JSON.
+func NewAnyLoader(source *anypb.Any) JSONLoader {
+	return &jsonAnyLoader{source: source}
+}
+
+func (l *jsonAnyLoader) LoadJSON() (interface{}, error) {
+
+	message, err := l.source.UnmarshalNew()
+	if err != nil {
+		return nil, err
+	}
+
+	return NewProtoLoader(message).LoadJSON()
+}
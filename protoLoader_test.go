@@ -0,0 +1,53 @@
+package gojsonschema
+
+import (
+	"testing"
+	"time"
+
+	"google.golang.org/protobuf/types/known/anypb"
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+func TestProtoLoaderRendersTimestampAsRFC3339(t *testing.T) {
+
+	when := time.Date(2026, 7, 27, 12, 0, 0, 0, time.UTC)
+	loader := NewProtoLoader(timestamppb.New(when))
+
+	document, err := loader.LoadJSON()
+	if err != nil {
+		t.Fatalf("LoadJSON: %v", err)
+	}
+
+	rendered, ok := document.(string)
+	if !ok {
+		t.Fatalf("expected a string, got %T", document)
+	}
+
+	if rendered != "2026-07-27T12:00:00Z" {
+		t.Fatalf("expected an RFC 3339 timestamp, got %s", rendered)
+	}
+}
+
+func TestAnyLoaderUnpacksBeforeProjecting(t *testing.T) {
+
+	when := time.Date(2026, 7, 27, 12, 0, 0, 0, time.UTC)
+
+	any, err := anypb.New(timestamppb.New(when))
+	if err != nil {
+		t.Fatalf("anypb.New: %v", err)
+	}
+
+	document, err := NewAnyLoader(any).LoadJSON()
+	if err != nil {
+		t.Fatalf("LoadJSON: %v", err)
+	}
+
+	rendered, ok := document.(string)
+	if !ok {
+		t.Fatalf("expected a string, got %T", document)
+	}
+
+	if rendered != "2026-07-27T12:00:00Z" {
+		t.Fatalf("expected an RFC 3339 timestamp, got %s", rendered)
+	}
+}
@@ -0,0 +1,99 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description		Caching for remote ($ref) schema documents, keyed by their canonical URL.
+//
+// created          27-07-2026
+
+package gojsonschema
+
+import (
+	"container/list"
+	"sync"
+)
+
+// SchemaCache is consulted by a jsonReferenceLoader before a remote ($ref)
+// document is fetched over the network or from disk, and is populated with
+// whatever the loader resolves. Implementations must be safe for concurrent use.
+type SchemaCache interface {
+	// Get returns the previously cached document for the given canonical URL.
+	Get(url string) (document interface{}, ok bool)
+	// Set stores document under the given canonical URL.
+	Set(url string, document interface{})
+}
+
+// memorySchemaCache is the default SchemaCache: an in-memory LRU keyed by
+// canonical URL with a bounded number of entries.
+type memorySchemaCache struct {
+	mu       sync.Mutex
+	capacity int
+	entries  map[string]*list.Element
+	order    *list.List
+}
+
+type memorySchemaCacheEntry struct {
+	url      string
+	document interface{}
+}
+
+// NewMemorySchemaCache returns a SchemaCache backed by an in-memory LRU holding
+// at most capacity documents. A capacity of 0 or less means unbounded.
+func NewMemorySchemaCache(capacity int) SchemaCache {
+	return &memorySchemaCache{
+		capacity: capacity,
+		entries:  make(map[string]*list.Element),
+		order:    list.New(),
+	}
+}
+
+func (c *memorySchemaCache) Get(url string) (interface{}, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	element, ok := c.entries[url]
+	if !ok {
+		return nil, false
+	}
+	c.order.MoveToFront(element)
+	return element.Value.(*memorySchemaCacheEntry).document, true
+}
+
+func (c *memorySchemaCache) Set(url string, document interface{}) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if element, ok := c.entries[url]; ok {
+		element.Value.(*memorySchemaCacheEntry).document = document
+		c.order.MoveToFront(element)
+		return
+	}
+
+	element := c.order.PushFront(&memorySchemaCacheEntry{url: url, document: document})
+	c.entries[url] = element
+
+	if c.capacity > 0 && c.order.Len() > c.capacity {
+		oldest := c.order.Back()
+		if oldest != nil {
+			c.order.Remove(oldest)
+			delete(c.entries, oldest.Value.(*memorySchemaCacheEntry).url)
+		}
+	}
+}
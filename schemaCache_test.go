@@ -0,0 +1,45 @@
+package gojsonschema
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+)
+
+func TestMemorySchemaCacheEviction(t *testing.T) {
+
+	cache := NewMemorySchemaCache(2)
+
+	cache.Set("a", "doc-a")
+	cache.Set("b", "doc-b")
+	cache.Set("c", "doc-c")
+
+	if _, ok := cache.Get("a"); ok {
+		t.Fatalf("expected \"a\" to have been evicted")
+	}
+
+	if doc, ok := cache.Get("b"); !ok || doc != "doc-b" {
+		t.Fatalf("expected \"b\" to still be cached, got %v, %v", doc, ok)
+	}
+
+	if doc, ok := cache.Get("c"); !ok || doc != "doc-c" {
+		t.Fatalf("expected \"c\" to still be cached, got %v, %v", doc, ok)
+	}
+}
+
+func TestMemorySchemaCacheConcurrentAccess(t *testing.T) {
+
+	cache := NewMemorySchemaCache(16)
+
+	var wg sync.WaitGroup
+	for i := 0; i < 64; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			url := fmt.Sprintf("http://example.com/schema-%d.json", i%8)
+			cache.Set(url, i)
+			cache.Get(url)
+		}(i)
+	}
+	wg.Wait()
+}
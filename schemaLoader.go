@@ -0,0 +1,101 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description		Resolves and pools the remote ($ref) schemas a root schema depends on.
+//
+// created          27-07-2026
+
+package gojsonschema
+
+import (
+	"net/http"
+
+	"github.com/xeipuuv/gojsonreference"
+)
+
+// SchemaLoader pools the remote schemas referenced by $ref while a root schema
+// is being assembled, and controls how those references are fetched.
+type SchemaLoader struct {
+	pool map[string]JSONLoader
+
+	// Client is used for http(s):// references. Defaults to an internal
+	// client with DefaultHTTPTimeout and DefaultMaxRedirects.
+	Client *http.Client
+
+	// FileSystem resolves file:// references when set, instead of the local OS file system.
+	FileSystem http.FileSystem
+
+	// Cache is consulted before any remote reference is fetched, and is
+	// populated with whatever is resolved. Nil disables caching.
+	Cache SchemaCache
+
+	// OfflineMode, when true, fails fast on any http(s):// reference that isn't
+	// already present in Cache instead of reaching out to the network.
+	// Un-cached file:// references are still read from disk.
+	OfflineMode bool
+}
+
+// NewSchemaLoader creates an empty SchemaLoader with no schemas added yet.
+func NewSchemaLoader() *SchemaLoader {
+	return &SchemaLoader{
+		pool: make(map[string]JSONLoader),
+	}
+}
+
+// AddSchema registers loader under ref, so that later $ref lookups for that
+// URL are served from the pool instead of being resolved again.
+func (sl *SchemaLoader) AddSchema(ref string, loader JSONLoader) error {
+	reference, err := gojsonreference.NewJsonReference(ref)
+	if err != nil {
+		return err
+	}
+
+	sl.pool[reference.String()] = loader
+	return nil
+}
+
+// loaderFor returns a JSONLoader configured with this SchemaLoader's transport,
+// file system, cache and offline-mode settings for the given reference.
+func (sl *SchemaLoader) loaderFor(source string) JSONLoader {
+	return &jsonReferenceLoader{
+		source:      source,
+		client:      sl.Client,
+		fs:          sl.FileSystem,
+		cache:       sl.Cache,
+		offlineMode: sl.OfflineMode,
+	}
+}
+
+// GetSchema returns the document referenced by ref, either from the pool
+// added via AddSchema or, failing that, by resolving it as a file:// or
+// http(s):// reference subject to this SchemaLoader's Cache and OfflineMode.
+func (sl *SchemaLoader) GetSchema(ref string) (interface{}, error) {
+	reference, err := gojsonreference.NewJsonReference(ref)
+	if err != nil {
+		return nil, err
+	}
+
+	if loader, ok := sl.pool[reference.String()]; ok {
+		return loader.LoadJSON()
+	}
+
+	return sl.loaderFor(ref).LoadJSON()
+}
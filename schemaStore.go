@@ -0,0 +1,197 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description		Content-addressable pinning and on-disk caching for SchemaLoader.
+//
+// created          27-07-2026
+
+package gojsonschema
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"sort"
+)
+
+// SchemaDigest returns the "sha256-<hex>" digest of document's canonical JSON
+// form: object keys sorted, json.Number values rewritten in a single decimal
+// form (so 1, 1.0 and 1e0 hash alike), and insignificant whitespace stripped. Two documents that are equal as JSON
+// values, regardless of original key order or formatting, produce the same digest.
+func SchemaDigest(document interface{}) (string, error) {
+
+	canonical, err := canonicalizeJSON(document)
+	if err != nil {
+		return "", err
+	}
+
+	sum := sha256.Sum256(canonical)
+	return "sha256-" + hex.EncodeToString(sum[:]), nil
+}
+
+func canonicalizeJSON(value interface{}) ([]byte, error) {
+	switch v := value.(type) {
+
+	case map[string]interface{}:
+		keys := make([]string, 0, len(v))
+		for key := range v {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		var buf []byte
+		buf = append(buf, '{')
+		for i, key := range keys {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			keyBytes, err := json.Marshal(key)
+			if err != nil {
+				return nil, err
+			}
+			buf = append(buf, keyBytes...)
+			buf = append(buf, ':')
+
+			valueBytes, err := canonicalizeJSON(v[key])
+			if err != nil {
+				return nil, err
+			}
+			buf = append(buf, valueBytes...)
+		}
+		buf = append(buf, '}')
+		return buf, nil
+
+	case []interface{}:
+		var buf []byte
+		buf = append(buf, '[')
+		for i, child := range v {
+			if i > 0 {
+				buf = append(buf, ',')
+			}
+			childBytes, err := canonicalizeJSON(child)
+			if err != nil {
+				return nil, err
+			}
+			buf = append(buf, childBytes...)
+		}
+		buf = append(buf, ']')
+		return buf, nil
+
+	case json.Number:
+		f, _, err := big.ParseFloat(v.String(), 10, 1024, big.ToNearestEven)
+		if err != nil {
+			return nil, err
+		}
+		return []byte(f.Text('g', -1)), nil
+
+	default:
+		return json.Marshal(v)
+	}
+}
+
+// AddSchemaPinned behaves like AddSchema, but first loads the document from
+// loader and computes its SchemaDigest. If digest doesn't match the expected
+// "sha256-..." value, the schema is refused and is not added to the pool.
+func (sl *SchemaLoader) AddSchemaPinned(ref string, loader JSONLoader, digest string) error {
+
+	document, err := loader.LoadJSON()
+	if err != nil {
+		return err
+	}
+
+	actual, err := SchemaDigest(document)
+	if err != nil {
+		return err
+	}
+
+	if actual != digest {
+		return fmt.Errorf("gojsonschema: refusing to compile %q: expected digest %s, got %s", ref, digest, actual)
+	}
+
+	return sl.AddSchema(ref, NewRawLoader(document))
+}
+
+// fileSchemaCache is a SchemaCache that persists resolved documents to disk,
+// so that repeated CLI runs can skip the network entirely once warm.
+type fileSchemaCache struct {
+	dir string
+}
+
+// DefaultSchemaCacheDir returns $XDG_CACHE_HOME/gojsonschema, falling back to
+// os.UserCacheDir()/gojsonschema when XDG_CACHE_HOME is unset.
+func DefaultSchemaCacheDir() (string, error) {
+	if xdg := os.Getenv("XDG_CACHE_HOME"); xdg != "" {
+		return filepath.Join(xdg, "gojsonschema"), nil
+	}
+
+	base, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+
+	return filepath.Join(base, "gojsonschema"), nil
+}
+
+// NewFileSchemaCache returns a SchemaCache backed by dir, creating it if
+// necessary. Entries are keyed by the sha256 of their canonical URL.
+func NewFileSchemaCache(dir string) (SchemaCache, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, err
+	}
+
+	return &fileSchemaCache{dir: dir}, nil
+}
+
+func (c *fileSchemaCache) path(url string) string {
+	sum := sha256.Sum256([]byte(url))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+func (c *fileSchemaCache) Get(url string) (interface{}, bool) {
+	raw, err := ioutil.ReadFile(c.path(url))
+	if err != nil {
+		return nil, false
+	}
+
+	var document interface{}
+	decoder := json.NewDecoder(bytes.NewReader(raw))
+	decoder.UseNumber()
+	if err := decoder.Decode(&document); err != nil {
+		return nil, false
+	}
+
+	return document, true
+}
+
+func (c *fileSchemaCache) Set(url string, document interface{}) {
+	raw, err := json.Marshal(document)
+	if err != nil {
+		return
+	}
+
+	_ = ioutil.WriteFile(c.path(url), raw, 0o644)
+}
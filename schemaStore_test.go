@@ -0,0 +1,79 @@
+package gojsonschema
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestSchemaDigestIsStableUnderKeyOrderAndWhitespace(t *testing.T) {
+
+	a := map[string]interface{}{
+		"type":       "object",
+		"properties": map[string]interface{}{"id": map[string]interface{}{"type": "integer"}},
+	}
+	b := map[string]interface{}{
+		"properties": map[string]interface{}{"id": map[string]interface{}{"type": "integer"}},
+		"type":       "object",
+	}
+
+	digestA, err := SchemaDigest(a)
+	if err != nil {
+		t.Fatalf("SchemaDigest(a): %v", err)
+	}
+
+	digestB, err := SchemaDigest(b)
+	if err != nil {
+		t.Fatalf("SchemaDigest(b): %v", err)
+	}
+
+	if digestA != digestB {
+		t.Fatalf("expected equal documents with different key order to share a digest, got %s vs %s", digestA, digestB)
+	}
+}
+
+func TestSchemaDigestDistinguishesDifferentNumbers(t *testing.T) {
+
+	a, err := SchemaDigest(map[string]interface{}{"n": json.Number("1")})
+	if err != nil {
+		t.Fatalf("SchemaDigest: %v", err)
+	}
+
+	b, err := SchemaDigest(map[string]interface{}{"n": json.Number("2")})
+	if err != nil {
+		t.Fatalf("SchemaDigest: %v", err)
+	}
+
+	if a == b {
+		t.Fatalf("expected different numeric values to produce different digests")
+	}
+}
+
+func TestAddSchemaPinnedRejectsDigestMismatch(t *testing.T) {
+
+	sl := NewSchemaLoader()
+
+	err := sl.AddSchemaPinned("https://example.com/schema.json", NewStringLoader(`{"type":"object"}`), "sha256-0000000000000000000000000000000000000000000000000000000000000000")
+	if err == nil {
+		t.Fatalf("expected a digest mismatch to be rejected")
+	}
+}
+
+func TestAddSchemaPinnedAcceptsMatchingDigest(t *testing.T) {
+
+	sl := NewSchemaLoader()
+	loader := NewStringLoader(`{"type":"object"}`)
+
+	document, err := loader.LoadJSON()
+	if err != nil {
+		t.Fatalf("LoadJSON: %v", err)
+	}
+
+	digest, err := SchemaDigest(document)
+	if err != nil {
+		t.Fatalf("SchemaDigest: %v", err)
+	}
+
+	if err := sl.AddSchemaPinned("https://example.com/schema.json", NewStringLoader(`{"type":"object"}`), digest); err != nil {
+		t.Fatalf("AddSchemaPinned: %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description		Validates an ndjson/JSON Lines stream one record at a time.
+//
+// created          27-07-2026
+
+package gojsonschema
+
+import "io"
+
+// ValidateStream validates a ndjson/JSON Lines stream against the schema, one
+// record at a time, without buffering the whole stream in memory. Results are
+// emitted on the returned results channel in stream order. Both channels are
+// closed once r is exhausted; if a read, decode, or validation error aborts
+// the stream early, it is sent on the returned errs channel before results
+// closes, so callers can tell a clean end-of-stream apart from a truncated one.
+func (v *Schema) ValidateStream(r io.Reader) (results <-chan Result, errs <-chan error, err error) {
+
+	loader := NewNDJSONReaderLoader(r).(NDJSONLoader)
+
+	resultsCh := make(chan Result)
+	errsCh := make(chan error, 1)
+
+	go func() {
+		defer close(resultsCh)
+		defer close(errsCh)
+
+		for {
+			record, nextErr := loader.Next()
+			if nextErr == io.EOF {
+				return
+			}
+			if nextErr != nil {
+				errsCh <- nextErr
+				return
+			}
+
+			result, validateErr := v.Validate(NewRawLoader(record))
+			if validateErr != nil {
+				errsCh <- validateErr
+				return
+			}
+
+			resultsCh <- *result
+		}
+	}()
+
+	return resultsCh, errsCh, nil
+}
@@ -0,0 +1,348 @@
+// Copyright 2015 xeipuuv ( https://github.com/xeipuuv )
+//
+// Licensed under the Apache License, Version 2.0 (the "License");
+// you may not use this file except in compliance with the License.
+// You may obtain a copy of the License at
+//
+//   http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS,
+// WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+// See the License for the specific language governing permissions and
+// limitations under the License.
+
+// author           xeipuuv
+// author-github    https://github.com/xeipuuv
+// author-mail      xeipuuv@gmail.com
+//
+// repository-name  gojsonschema
+// repository-desc  An implementation of JSON Schema, based on IETF's draft v4 - Go language.
+//
+// description		YAML loaders that decode into the same interface{} shape as the JSON loaders.
+//
+// created          27-07-2026
+
+package gojsonschema
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"math/big"
+	"strconv"
+	"strings"
+
+	"github.com/xeipuuv/gojsonreference"
+	"gopkg.in/yaml.v3"
+)
+
+// JSON YAML string loader
+
+type jsonYAMLStringLoader struct {
+	source string
+}
+
+func (l *jsonYAMLStringLoader) JsonSource() interface{} {
+	return l.source
+}
+
+func (l *jsonYAMLStringLoader) JsonReference() (gojsonreference.JsonReference, error) {
+	return gojsonreference.NewJsonReference("#")
+}
+
+func (l *jsonYAMLStringLoader) LoaderFactory() JSONLoaderFactory {
+	return &DefaultJSONLoaderFactory{}
+}
+
+// NewYAMLStringLoader creates a new JSONLoader, taking a YAML string as source
+func NewYAMLStringLoader(source string) JSONLoader {
+	return &jsonYAMLStringLoader{source: source}
+}
+
+func (l *jsonYAMLStringLoader) LoadJSON() (interface{}, error) {
+	return decodeYAMLUsingNumber(strings.NewReader(l.JsonSource().(string)))
+}
+
+// JSON YAML bytes loader
+
+type jsonYAMLBytesLoader struct {
+	source []byte
+}
+
+func (l *jsonYAMLBytesLoader) JsonSource() interface{} {
+	return l.source
+}
+
+func (l *jsonYAMLBytesLoader) JsonReference() (gojsonreference.JsonReference, error) {
+	return gojsonreference.NewJsonReference("#")
+}
+
+func (l *jsonYAMLBytesLoader) LoaderFactory() JSONLoaderFactory {
+	return &DefaultJSONLoaderFactory{}
+}
+
+// NewYAMLBytesLoader creates a new JSONLoader, taking a `[]byte` of YAML as source
+func NewYAMLBytesLoader(source []byte) JSONLoader {
+	return &jsonYAMLBytesLoader{source: source}
+}
+
+func (l *jsonYAMLBytesLoader) LoadJSON() (interface{}, error) {
+	return decodeYAMLUsingNumber(bytes.NewReader(l.JsonSource().([]byte)))
+}
+
+// JSON YAML reader loader
+
+type jsonYAMLReaderLoader struct {
+	buf *bytes.Buffer
+}
+
+// NewYAMLReaderLoader creates a new JSON loader using the provided io.Reader of YAML
+func NewYAMLReaderLoader(source io.Reader) (JSONLoader, io.Reader) {
+	buf := &bytes.Buffer{}
+	return &jsonYAMLReaderLoader{buf: buf}, io.TeeReader(source, buf)
+}
+
+func (l *jsonYAMLReaderLoader) JsonSource() interface{} {
+	return l.buf.String()
+}
+
+func (l *jsonYAMLReaderLoader) JsonReference() (gojsonreference.JsonReference, error) {
+	return gojsonreference.NewJsonReference("#")
+}
+
+func (l *jsonYAMLReaderLoader) LoaderFactory() JSONLoaderFactory {
+	return &DefaultJSONLoaderFactory{}
+}
+
+func (l *jsonYAMLReaderLoader) LoadJSON() (interface{}, error) {
+	return decodeYAMLUsingNumber(l.buf)
+}
+
+// decodeYAMLUsingNumber decodes a single YAML document from r into the same
+// interface{} shape decodeJSONUsingNumber produces: nested maps are always
+// map[string]interface{}, and scalar numbers are json.Number rather than
+// float64, so the result can be validated exactly like parsed JSON.
+func decodeYAMLUsingNumber(r io.Reader) (interface{}, error) {
+
+	raw, err := ioutil.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	var node yaml.Node
+	if err := yaml.Unmarshal(raw, &node); err != nil {
+		return nil, err
+	}
+
+	if len(node.Content) == 0 {
+		return nil, nil
+	}
+
+	return yamlNodeToJSON(node.Content[0])
+}
+
+func yamlNodeToJSON(node *yaml.Node) (interface{}, error) {
+
+	switch node.Kind {
+
+	case yaml.DocumentNode:
+		if len(node.Content) == 0 {
+			return nil, nil
+		}
+		return yamlNodeToJSON(node.Content[0])
+
+	case yaml.MappingNode:
+		result := make(map[string]interface{}, len(node.Content)/2)
+		seen := make(map[string]bool, len(node.Content)/2)
+
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keyNode := node.Content[i]
+			valueNode := node.Content[i+1]
+
+			var key string
+			if err := keyNode.Decode(&key); err != nil {
+				return nil, fmt.Errorf("gojsonschema: non-string YAML mapping key at line %d", keyNode.Line)
+			}
+
+			if seen[key] {
+				return nil, fmt.Errorf("gojsonschema: duplicate YAML key %q at line %d", key, keyNode.Line)
+			}
+			seen[key] = true
+
+			value, err := yamlNodeToJSON(valueNode)
+			if err != nil {
+				return nil, err
+			}
+			result[key] = value
+		}
+
+		return result, nil
+
+	case yaml.SequenceNode:
+		result := make([]interface{}, len(node.Content))
+		for i, child := range node.Content {
+			value, err := yamlNodeToJSON(child)
+			if err != nil {
+				return nil, err
+			}
+			result[i] = value
+		}
+		return result, nil
+
+	case yaml.ScalarNode:
+		switch node.Tag {
+		case "!!int", "!!float":
+			return yamlScalarToJSONNumber(node)
+		case "!!null":
+			return nil, nil
+		default:
+			var value interface{}
+			if err := node.Decode(&value); err != nil {
+				return nil, err
+			}
+			return value, nil
+		}
+
+	case yaml.AliasNode:
+		return yamlNodeToJSON(node.Alias)
+
+	default:
+		return nil, fmt.Errorf("gojsonschema: unsupported YAML node kind %v", node.Kind)
+	}
+}
+
+// yamlScalarToJSONNumber normalizes a YAML 1.1 numeric literal - which
+// accepts forms JSON numbers don't, such as "0x1A", "0o17", "1_000_000" and
+// sexagesimal "1:30:00" - into a plain decimal json.Number. Normalizing here,
+// at load time, means a later .Float64()/.Int64() call made while validating
+// `type: number` or `multipleOf` never trips over YAML-only syntax.
+func yamlScalarToJSONNumber(node *yaml.Node) (interface{}, error) {
+
+	var decimal string
+	var err error
+
+	switch node.Tag {
+	case "!!int":
+		decimal, err = yamlParseInt(node.Value)
+	case "!!float":
+		decimal, err = yamlParseFloat(node.Value)
+	default:
+		err = fmt.Errorf("unsupported numeric tag %q", node.Tag)
+	}
+
+	if err != nil {
+		return nil, fmt.Errorf("gojsonschema: invalid YAML number %q at line %d: %v", node.Value, node.Line, err)
+	}
+
+	return json.Number(decimal), nil
+}
+
+func yamlParseInt(raw string) (string, error) {
+
+	s := strings.ReplaceAll(raw, "_", "")
+
+	neg := false
+	switch {
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	case strings.HasPrefix(s, "-"):
+		neg = true
+		s = s[1:]
+	}
+
+	if strings.Contains(s, ":") {
+		return yamlParseSexagesimalInt(s, neg)
+	}
+
+	base := 10
+	switch {
+	case strings.HasPrefix(s, "0x") || strings.HasPrefix(s, "0X"):
+		base, s = 16, s[2:]
+	case strings.HasPrefix(s, "0o") || strings.HasPrefix(s, "0O"):
+		base, s = 8, s[2:]
+	case len(s) > 1 && s[0] == '0':
+		base = 8
+	}
+
+	value := new(big.Int)
+	if _, ok := value.SetString(s, base); !ok {
+		return "", fmt.Errorf("not a valid base-%d integer", base)
+	}
+	if neg {
+		value.Neg(value)
+	}
+
+	return value.String(), nil
+}
+
+func yamlParseSexagesimalInt(s string, neg bool) (string, error) {
+
+	value := new(big.Int)
+	sixty := big.NewInt(60)
+
+	for _, part := range strings.Split(s, ":") {
+		n := new(big.Int)
+		if _, ok := n.SetString(part, 10); !ok {
+			return "", fmt.Errorf("not a valid sexagesimal component %q", part)
+		}
+		value.Mul(value, sixty)
+		value.Add(value, n)
+	}
+
+	if neg {
+		value.Neg(value)
+	}
+
+	return value.String(), nil
+}
+
+func yamlParseFloat(raw string) (string, error) {
+
+	s := strings.ReplaceAll(raw, "_", "")
+
+	switch strings.ToLower(s) {
+	case ".inf", "+.inf", "-.inf", ".nan":
+		return "", fmt.Errorf("%q has no JSON representation", raw)
+	}
+
+	if strings.ContainsAny(s, ":") {
+		return yamlParseSexagesimalFloat(s)
+	}
+
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.FormatFloat(f, 'g', -1, 64), nil
+}
+
+func yamlParseSexagesimalFloat(s string) (string, error) {
+
+	neg := false
+	switch {
+	case strings.HasPrefix(s, "+"):
+		s = s[1:]
+	case strings.HasPrefix(s, "-"):
+		neg = true
+		s = s[1:]
+	}
+
+	var value float64
+	for _, part := range strings.Split(s, ":") {
+		n, err := strconv.ParseFloat(part, 64)
+		if err != nil {
+			return "", err
+		}
+		value = value*60 + n
+	}
+
+	if neg {
+		value = -value
+	}
+
+	return strconv.FormatFloat(value, 'g', -1, 64), nil
+}
@@ -0,0 +1,74 @@
+package gojsonschema
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestYAMLLoaderNormalizesNumericLiterals(t *testing.T) {
+
+	loader := NewYAMLStringLoader(`
+hex: 0x1A
+octal: 0o17
+underscored: 1_000_000
+sexagesimal: "1:30:00"
+plain: 42
+`)
+
+	document, err := loader.LoadJSON()
+	if err != nil {
+		t.Fatalf("LoadJSON: %v", err)
+	}
+
+	m, ok := document.(map[string]interface{})
+	if !ok {
+		t.Fatalf("expected a map, got %T", document)
+	}
+
+	hex, ok := m["hex"].(json.Number)
+	if !ok {
+		t.Fatalf("expected hex to be a json.Number, got %T", m["hex"])
+	}
+	if hex.String() != "26" {
+		t.Fatalf("expected 0x1A to normalize to 26, got %s", hex.String())
+	}
+
+	octal, ok := m["octal"].(json.Number)
+	if !ok || octal.String() != "15" {
+		t.Fatalf("expected 0o17 to normalize to 15, got %v", m["octal"])
+	}
+
+	underscored, ok := m["underscored"].(json.Number)
+	if !ok || underscored.String() != "1000000" {
+		t.Fatalf("expected 1_000_000 to normalize to 1000000, got %v", m["underscored"])
+	}
+
+	plain, ok := m["plain"].(json.Number)
+	if !ok || plain.String() != "42" {
+		t.Fatalf("expected plain 42 to stay a json.Number, got %v", m["plain"])
+	}
+
+	// "1:30:00" is quoted above (a plain string), so sexagesimal handling is
+	// exercised directly against the parser rather than through the loader,
+	// since yaml.v3 resolves sexagesimal scalars as strings unless they carry
+	// an explicit !!int tag.
+	decimal, err := yamlParseInt("1:30:00")
+	if err != nil {
+		t.Fatalf("yamlParseInt: %v", err)
+	}
+	if decimal != "5400" {
+		t.Fatalf("expected 1:30:00 to normalize to 5400, got %s", decimal)
+	}
+}
+
+func TestYAMLLoaderRejectsDuplicateKeys(t *testing.T) {
+
+	loader := NewYAMLStringLoader(`
+a: 1
+a: 2
+`)
+
+	if _, err := loader.LoadJSON(); err == nil {
+		t.Fatalf("expected an error for duplicate YAML keys")
+	}
+}